@@ -0,0 +1,109 @@
+package encoding
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+)
+
+// decodeDynamicBytesArg reads the head/tail encoding of the argIndex-th `bytes` argument in an
+// ABI-encoded argument list, without unpacking it, and returns the offset and length of its
+// contents within data so the caller can slice them out directly.
+//
+// All bounds checks below compare against dataLen via subtraction rather than adding the
+// attacker-controlled offset/length words, since those words come straight off the wire and an
+// addition-based check can silently wrap around and pass for a huge offset/length.
+func decodeDynamicBytesArg(data []byte, argIndex int) (offset int, length int, err error) {
+	dataLen := uint64(len(data))
+
+	headPos := uint64(argIndex) * 32
+	if headPos+32 < headPos || headPos+32 > dataLen {
+		return 0, 0, fmt.Errorf("calldata too short to read arg %d offset", argIndex)
+	}
+
+	relOffsetBig := new(big.Int).SetBytes(data[headPos : headPos+32])
+	if !relOffsetBig.IsUint64() {
+		return 0, 0, fmt.Errorf("arg %d offset does not fit in 64 bits", argIndex)
+	}
+	relOffset := relOffsetBig.Uint64()
+	if relOffset > dataLen || dataLen-relOffset < 32 {
+		return 0, 0, fmt.Errorf("calldata too short to read arg %d length", argIndex)
+	}
+
+	argLengthBig := new(big.Int).SetBytes(data[relOffset : relOffset+32])
+	if !argLengthBig.IsUint64() {
+		return 0, 0, fmt.Errorf("arg %d length does not fit in 64 bits", argIndex)
+	}
+	argLength := argLengthBig.Uint64()
+	argOffset := relOffset + 32
+	if argOffset > dataLen || dataLen-argOffset < argLength {
+		return 0, 0, fmt.Errorf("calldata too short to read arg %d contents", argIndex)
+	}
+
+	if argOffset > uint64(math.MaxInt) || argLength > uint64(math.MaxInt) {
+		return 0, 0, fmt.Errorf("arg %d offset/length too large", argIndex)
+	}
+
+	return int(argOffset), int(argLength), nil
+}
+
+// DecodeProposeBlockCalldata walks the ABI head/tail regions of a TaikoL1.proposeBlock
+// transaction's calldata manually, using TaikoL1ABI.Methods["proposeBlock"].Inputs' offsets,
+// and invokes visit once per input field with its byte range within txData. This lets callers
+// that only need one field (e.g. `_txList`) slice it out as a zero-copy view into the original
+// calldata, instead of unpacking the whole call into a map first.
+func DecodeProposeBlockCalldata(txData []byte, visit func(field string, rawOffset, rawLen int) error) error {
+	method, err := TaikoL1ABI.MethodById(txData)
+	if err != nil {
+		return err
+	}
+
+	// Only check for safety.
+	if method.Name != "proposeBlock" {
+		return fmt.Errorf("invalid method name: %s", method.Name)
+	}
+
+	payload := txData[4:]
+
+	for i, input := range method.Inputs {
+		offset, length, err := decodeDynamicBytesArg(payload, i)
+		if err != nil {
+			return fmt.Errorf("failed to decode %s, %w", input.Name, err)
+		}
+
+		if err := visit(input.Name, 4+offset, length); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// DecodeProposeBlockOntakeCalldata is DecodeProposeBlockCalldata's counterpart for the ontake
+// `proposeBlock2(bytes _params, bytes _txList)` shape used by bindings.LibProposingMetaData.
+func DecodeProposeBlockOntakeCalldata(txData []byte, visit func(field string, rawOffset, rawLen int) error) error {
+	method, err := LibProposingABI.MethodById(txData)
+	if err != nil {
+		return err
+	}
+
+	// Only check for safety.
+	if method.Name != "proposeBlock2" {
+		return fmt.Errorf("invalid method name: %s", method.Name)
+	}
+
+	payload := txData[4:]
+
+	for i, input := range method.Inputs {
+		offset, length, err := decodeDynamicBytesArg(payload, i)
+		if err != nil {
+			return fmt.Errorf("failed to decode %s, %w", input.Name, err)
+		}
+
+		if err := visit(input.Name, 4+offset, length); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
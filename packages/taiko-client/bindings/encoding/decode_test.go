@@ -0,0 +1,92 @@
+package encoding
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// word32 returns v as a 32-byte big-endian ABI word.
+func word32(v uint64) []byte {
+	w := make([]byte, 32)
+	for i := 0; i < 8; i++ {
+		w[31-i] = byte(v >> (8 * i))
+	}
+	return w
+}
+
+func TestDecodeDynamicBytesArg_Valid(t *testing.T) {
+	content := []byte("hello taiko")
+
+	data := append([]byte{}, word32(32)...) // offset to tail
+	data = append(data, word32(uint64(len(content)))...)
+	data = append(data, content...)
+
+	offset, length, err := decodeDynamicBytesArg(data, 0)
+	require.NoError(t, err)
+	require.Equal(t, content, data[offset:offset+length])
+}
+
+func TestDecodeDynamicBytesArg_TooShortForOffsetWord(t *testing.T) {
+	_, _, err := decodeDynamicBytesArg(make([]byte, 16), 0)
+	require.Error(t, err)
+}
+
+func TestDecodeDynamicBytesArg_TooShortForLengthWord(t *testing.T) {
+	// Offset points past the end of the buffer, so the length word can't be read.
+	data := word32(1000)
+
+	_, _, err := decodeDynamicBytesArg(data, 0)
+	require.Error(t, err)
+}
+
+func TestDecodeDynamicBytesArg_RejectsOffsetOverflow(t *testing.T) {
+	// relOffset + 32 would wrap around uint64 if computed via unchecked addition.
+	data := append([]byte{}, word32(math.MaxUint64-16)...)
+	data = append(data, make([]byte, 64)...)
+
+	_, _, err := decodeDynamicBytesArg(data, 0)
+	require.Error(t, err)
+}
+
+func TestDecodeDynamicBytesArg_RejectsLengthOverflow(t *testing.T) {
+	// argOffset + argLength would wrap around uint64 if computed via unchecked addition.
+	data := append([]byte{}, word32(32)...)
+	data = append(data, word32(math.MaxUint64-16)...)
+	data = append(data, make([]byte, 64)...)
+
+	_, _, err := decodeDynamicBytesArg(data, 0)
+	require.Error(t, err)
+}
+
+func TestDecodeDynamicBytesArg_RejectsOffsetAbove64Bits(t *testing.T) {
+	// A word encoding a value >= 2^64 would silently truncate to a small, in-bounds offset if
+	// converted via an unchecked big.Int.Uint64(), bypassing the bounds checks below it.
+	data := make([]byte, 32)
+	data[0] = 0x01 // sets bit 248, far above the low 64 bits
+	data = append(data, make([]byte, 64)...)
+
+	_, _, err := decodeDynamicBytesArg(data, 0)
+	require.ErrorContains(t, err, "does not fit in 64 bits")
+}
+
+func TestDecodeDynamicBytesArg_RejectsLengthAbove64Bits(t *testing.T) {
+	data := append([]byte{}, word32(32)...)
+	lengthWord := make([]byte, 32)
+	lengthWord[0] = 0x01
+	data = append(data, lengthWord...)
+	data = append(data, make([]byte, 64)...)
+
+	_, _, err := decodeDynamicBytesArg(data, 0)
+	require.ErrorContains(t, err, "does not fit in 64 bits")
+}
+
+func TestDecodeDynamicBytesArg_RejectsTruncatedContent(t *testing.T) {
+	data := append([]byte{}, word32(32)...)
+	data = append(data, word32(100)...) // claims 100 bytes of content
+	data = append(data, make([]byte, 10)...)
+
+	_, _, err := decodeDynamicBytesArg(data, 0)
+	require.Error(t, err)
+}
@@ -0,0 +1,116 @@
+package encoding
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// errorStringSelector and panicUint256Selector are the 4-byte selectors of solidity's built-in
+// `Error(string)` and `Panic(uint256)` revert reasons, used as a fallback when the revert data
+// doesn't match any custom error in customErrorMaps.
+var (
+	errorStringSelector  = crypto.Keccak256([]byte("Error(string)"))[:4]
+	panicUint256Selector = crypto.Keccak256([]byte("Panic(uint256)"))[:4]
+)
+
+// errorStringType is the ABI `string` type, used to unpack the standard `Error(string)` revert
+// reason.
+var errorStringType, _ = abi.NewType("string", "", nil)
+
+// RevertError is a human-readable representation of a contract revert, decoded from raw eth_call
+// / eth_sendRawTransaction error data via UnwrapRPCError.
+type RevertError struct {
+	Name string
+	Args map[string]any
+	Raw  []byte
+}
+
+// Error implements the error interface.
+func (e *RevertError) Error() string {
+	return fmt.Sprintf("%s%v", e.Name, e.Args)
+}
+
+// DecodeRevertReason decodes the given revert data against every custom error bound in
+// customErrorMaps, falling back to the standard `Error(string)` / `Panic(uint256)` reasons if no
+// custom error matches.
+func DecodeRevertReason(data []byte) (name string, args map[string]any, err error) {
+	if len(data) < 4 {
+		return "", nil, errors.New("revert data too short to contain a selector")
+	}
+
+	selector, payload := data[:4], data[4:]
+
+	for _, errs := range customErrorMaps {
+		for _, abiErr := range errs {
+			if !bytes.Equal(abiErr.ID[:4], selector) {
+				continue
+			}
+
+			values := map[string]any{}
+			if err := abiErr.Inputs.UnpackIntoMap(values, payload); err != nil {
+				return "", nil, fmt.Errorf("failed to unpack custom error %s, %w", abiErr.Name, err)
+			}
+
+			return abiErr.Name, values, nil
+		}
+	}
+
+	switch {
+	case bytes.Equal(selector, errorStringSelector):
+		unpacked, err := (abi.Arguments{{Type: errorStringType}}).UnpackValues(payload)
+		if err != nil || len(unpacked) == 0 {
+			return "", nil, fmt.Errorf("failed to unpack Error(string) revert reason, %w", err)
+		}
+
+		return "Error", map[string]any{"message": unpacked[0]}, nil
+	case bytes.Equal(selector, panicUint256Selector):
+		if len(payload) < 32 {
+			return "", nil, errors.New("Panic(uint256) revert data too short")
+		}
+
+		return "Panic", map[string]any{"code": binary.BigEndian.Uint64(payload[24:32])}, nil
+	default:
+		return "", nil, fmt.Errorf("unknown revert selector: 0x%x", selector)
+	}
+}
+
+// UnwrapRPCError inspects the given error for an underlying go-ethereum `rpc.DataError` (as
+// returned by eth_call / eth_sendRawTransaction when a transaction reverts), and rewrites it into
+// a typed *RevertError with a human-readable name and arguments, e.g. `L1_BLOB_NOT_FOUND`
+// instead of an opaque "execution reverted". If err doesn't carry revert data, it is returned
+// unchanged.
+func UnwrapRPCError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var dataErr rpc.DataError
+
+	if !errors.As(err, &dataErr) {
+		return err
+	}
+
+	raw, ok := dataErr.ErrorData().(string)
+	if !ok {
+		return err
+	}
+
+	data, decodeErr := hexutil.Decode(raw)
+	if decodeErr != nil {
+		return err
+	}
+
+	name, args, decodeErr := DecodeRevertReason(data)
+	if decodeErr != nil {
+		return err
+	}
+
+	return &RevertError{Name: name, Args: args, Raw: data}
+}
@@ -0,0 +1,102 @@
+package encoding
+
+import (
+	"encoding/binary"
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeRevertReason_StandardErrorString(t *testing.T) {
+	stringType, err := abi.NewType("string", "", nil)
+	require.NoError(t, err)
+
+	payload, err := (abi.Arguments{{Type: stringType}}).Pack("insufficient balance")
+	require.NoError(t, err)
+
+	data := append(append([]byte{}, errorStringSelector...), payload...)
+
+	name, args, err := DecodeRevertReason(data)
+	require.NoError(t, err)
+	require.Equal(t, "Error", name)
+	require.Equal(t, "insufficient balance", args["message"])
+}
+
+func TestDecodeRevertReason_StandardPanic(t *testing.T) {
+	payload := make([]byte, 32)
+	binary.BigEndian.PutUint64(payload[24:32], 0x11) // arithmetic overflow panic code
+
+	data := append(append([]byte{}, panicUint256Selector...), payload...)
+
+	name, args, err := DecodeRevertReason(data)
+	require.NoError(t, err)
+	require.Equal(t, "Panic", name)
+	require.Equal(t, uint64(0x11), args["code"])
+}
+
+func TestDecodeRevertReason_CustomError(t *testing.T) {
+	uint64Type, err := abi.NewType("uint64", "", nil)
+	require.NoError(t, err)
+
+	customErr := abi.NewError("L1_INVALID_BLOCK_ID", abi.Arguments{{Name: "blockId", Type: uint64Type}})
+
+	original := customErrorMaps
+	customErrorMaps = []map[string]abi.Error{{customErr.Name: customErr}}
+	defer func() { customErrorMaps = original }()
+
+	payload, err := customErr.Inputs.Pack(uint64(42))
+	require.NoError(t, err)
+
+	data := append(append([]byte{}, customErr.ID[:4]...), payload...)
+
+	name, args, err := DecodeRevertReason(data)
+	require.NoError(t, err)
+	require.Equal(t, "L1_INVALID_BLOCK_ID", name)
+	require.EqualValues(t, 42, args["blockId"])
+}
+
+func TestDecodeRevertReason_TooShort(t *testing.T) {
+	_, _, err := DecodeRevertReason([]byte{0x01, 0x02})
+	require.Error(t, err)
+}
+
+func TestDecodeRevertReason_UnknownSelector(t *testing.T) {
+	_, _, err := DecodeRevertReason([]byte{0xde, 0xad, 0xbe, 0xef})
+	require.Error(t, err)
+}
+
+// stubDataError implements rpc.DataError for UnwrapRPCError tests.
+type stubDataError struct {
+	msg  string
+	data any
+}
+
+func (e *stubDataError) Error() string  { return e.msg }
+func (e *stubDataError) ErrorData() any { return e.data }
+
+func TestUnwrapRPCError_DecodesRevertData(t *testing.T) {
+	stringType, err := abi.NewType("string", "", nil)
+	require.NoError(t, err)
+
+	payload, err := (abi.Arguments{{Type: stringType}}).Pack("nope")
+	require.NoError(t, err)
+
+	data := append(append([]byte{}, errorStringSelector...), payload...)
+
+	rpcErr := &stubDataError{msg: "execution reverted", data: hexutil.Encode(data)}
+
+	unwrapped := UnwrapRPCError(rpcErr)
+
+	var revertErr *RevertError
+	require.True(t, errors.As(unwrapped, &revertErr))
+	require.Equal(t, "Error", revertErr.Name)
+	require.Equal(t, "nope", revertErr.Args["message"])
+}
+
+func TestUnwrapRPCError_PassesThroughNonRevertErrors(t *testing.T) {
+	plain := errors.New("connection refused")
+	require.Equal(t, plain, UnwrapRPCError(plain))
+}
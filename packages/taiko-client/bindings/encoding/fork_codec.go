@@ -0,0 +1,153 @@
+package encoding
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/metadata"
+)
+
+// legacyForkCodec implements metadata.Fork for the pre-Ontake protocol fork.
+type legacyForkCodec struct{}
+
+// Version implements the metadata.Fork interface.
+func (legacyForkCodec) Version() metadata.ForkVersion { return metadata.ForkLegacy }
+
+// NewMetadata implements the metadata.Fork interface.
+func (legacyForkCodec) NewMetadata(event any) (metadata.TaikoBlockMetaData, error) {
+	e, ok := event.(*bindings.LibProposingBlockProposed)
+	if !ok {
+		return nil, fmt.Errorf("expected *bindings.LibProposingBlockProposed, got %T", event)
+	}
+
+	return metadata.NewTaikoDataBlockMetadata(e), nil
+}
+
+// ProveInputArgs implements the metadata.Fork interface.
+func (legacyForkCodec) ProveInputArgs() abi.Arguments { return proveBlockInputArgs }
+
+// PackProveInput implements the metadata.Fork interface.
+func (legacyForkCodec) PackProveInput(
+	meta metadata.TaikoBlockMetaData,
+	transition *bindings.TaikoDataTransition,
+	tierProof *bindings.TaikoDataTierProof,
+) ([]byte, error) {
+	b, err := proveBlockInputArgs.Pack(
+		meta.(*metadata.TaikoDataBlockMetadataLegacy).InnerMetadata(),
+		transition,
+		tierProof,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to abi.encode TaikoL1.proveBlock input, %w", err)
+	}
+	return b, nil
+}
+
+// UnpackInnerMetadata implements the metadata.Fork interface.
+func (legacyForkCodec) UnpackInnerMetadata(data []byte) (any, error) {
+	values, err := proveBlockInputArgs.Unpack(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to abi.decode TaikoL1.proveBlock input, %w", err)
+	}
+	if len(values) == 0 {
+		return nil, errors.New("empty TaikoL1.proveBlock input")
+	}
+
+	return values[0], nil
+}
+
+// ontakeForkCodec implements metadata.Fork for the Ontake protocol fork.
+type ontakeForkCodec struct{}
+
+// Version implements the metadata.Fork interface.
+func (ontakeForkCodec) Version() metadata.ForkVersion { return metadata.ForkOntake }
+
+// NewMetadata implements the metadata.Fork interface.
+func (ontakeForkCodec) NewMetadata(event any) (metadata.TaikoBlockMetaData, error) {
+	e, ok := event.(*bindings.LibProposingBlockProposed2)
+	if !ok {
+		return nil, fmt.Errorf("expected *bindings.LibProposingBlockProposed2, got %T", event)
+	}
+
+	return metadata.NewTaikoDataBlockMetadata2(e), nil
+}
+
+// ProveInputArgs implements the metadata.Fork interface.
+func (ontakeForkCodec) ProveInputArgs() abi.Arguments { return proveOntakeBlockInputArgs }
+
+// PackProveInput implements the metadata.Fork interface.
+func (ontakeForkCodec) PackProveInput(
+	meta metadata.TaikoBlockMetaData,
+	transition *bindings.TaikoDataTransition,
+	tierProof *bindings.TaikoDataTierProof,
+) ([]byte, error) {
+	b, err := proveOntakeBlockInputArgs.Pack(
+		meta.(*metadata.TaikoDataBlockMetadataOntake).InnerMetadata(),
+		transition,
+		tierProof,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to abi.encode TaikoL1.proveBlock input after ontake fork, %w", err)
+	}
+	return b, nil
+}
+
+// UnpackInnerMetadata implements the metadata.Fork interface.
+func (ontakeForkCodec) UnpackInnerMetadata(data []byte) (any, error) {
+	values, err := proveOntakeBlockInputArgs.Unpack(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to abi.decode TaikoL1.proveBlock input after ontake fork, %w", err)
+	}
+	if len(values) == 0 {
+		return nil, errors.New("empty TaikoL1.proveBlock input")
+	}
+
+	return values[0], nil
+}
+
+// preconfForkCodec implements metadata.Fork for ForkPreconf. A TaikoDataBlockMetadataPreconf is
+// built locally from gossip rather than from an on-chain event, and has no ABI-encoded on-chain
+// representation of its own, so NewMetadata, PackProveInput and UnpackInnerMetadata error and
+// ProveInputArgs returns no arguments, instead of silently mis-encoding or type-asserting into a
+// fork it isn't: the driver must reconcile preconfirmed metadata into a
+// real TaikoDataBlockMetadataOntake before it ever reaches the encoder.
+type preconfForkCodec struct{}
+
+// Version implements the metadata.Fork interface.
+func (preconfForkCodec) Version() metadata.ForkVersion { return metadata.ForkPreconf }
+
+// NewMetadata implements the metadata.Fork interface.
+func (preconfForkCodec) NewMetadata(event any) (metadata.TaikoBlockMetaData, error) {
+	return nil, fmt.Errorf("preconf metadata is built locally from gossip, not from an on-chain event, got %T", event)
+}
+
+// ProveInputArgs implements the metadata.Fork interface.
+func (preconfForkCodec) ProveInputArgs() abi.Arguments {
+	return abi.Arguments{}
+}
+
+// PackProveInput implements the metadata.Fork interface.
+func (preconfForkCodec) PackProveInput(
+	meta metadata.TaikoBlockMetaData,
+	_ *bindings.TaikoDataTransition,
+	_ *bindings.TaikoDataTierProof,
+) ([]byte, error) {
+	return nil, fmt.Errorf(
+		"cannot pack TaikoL1.proveBlock input for still-preconfirmed block %s, wait for on-chain reconciliation",
+		meta.GetBlockID(),
+	)
+}
+
+// UnpackInnerMetadata implements the metadata.Fork interface.
+func (preconfForkCodec) UnpackInnerMetadata([]byte) (any, error) {
+	return nil, errors.New("preconf fork has no ABI-encoded inner metadata to unpack")
+}
+
+func init() {
+	metadata.RegisterFork(legacyForkCodec{})
+	metadata.RegisterFork(ontakeForkCodec{})
+	metadata.RegisterFork(preconfForkCodec{})
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/ethereum/go-ethereum/log"
 
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/encoding/tiers"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/metadata"
 )
 
@@ -375,60 +376,53 @@ func EncodeBlockParamsOntake(params *BlockParams2) ([]byte, error) {
 	return b, nil
 }
 
-// EncodeProveBlockInput performs the solidity `abi.encode` for the given TaikoL1.proveBlock input.
+// EncodeProveBlockInput performs the solidity `abi.encode` for the given TaikoL1.proveBlock input,
+// dispatching to the metadata.Fork implementation registered for the metadata's fork version.
+// proofData is the tier-specific proof (e.g. *tiers.SGXProofData, tiers.ZKProofData), and is
+// encoded via the TierCodec registered for tierProof.Tier to fill tierProof.Data before packing.
 func EncodeProveBlockInput(
 	meta metadata.TaikoBlockMetaData,
 	transition *bindings.TaikoDataTransition,
 	tierProof *bindings.TaikoDataTierProof,
+	proofData any,
 ) ([]byte, error) {
-	var (
-		b   []byte
-		err error
-	)
-	if meta.IsOntakeBlock() {
-		if b, err = proveOntakeBlockInputArgs.Pack(
-			meta.(*metadata.TaikoDataBlockMetadataOntake).InnerMetadata(),
-			transition,
-			tierProof,
-		); err != nil {
-			return nil, fmt.Errorf("failed to abi.encode TakoL1.proveBlock input after ontake fork, %w", err)
-		}
-	} else {
-		if b, err = proveBlockInputArgs.Pack(
-			meta.(*metadata.TaikoDataBlockMetadataLegacy).InnerMetadata(),
-			transition,
-			tierProof,
-		); err != nil {
-			return nil, fmt.Errorf("failed to abi.encode TakoL1.proveBlock input, %w", err)
-		}
+	codec, ok := tiers.Get(tierProof.Tier)
+	if !ok {
+		return nil, fmt.Errorf("no TierCodec registered for tier %d", tierProof.Tier)
 	}
 
-	return b, nil
-}
-
-// UnpackTxListBytes unpacks the input data of a TaikoL1.proposeBlock transaction, and returns the txList bytes.
-func UnpackTxListBytes(txData []byte) ([]byte, error) {
-	method, err := TaikoL1ABI.MethodById(txData)
+	data, err := codec.EncodeProofData(proofData)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("failed to encode tier %d proof data, %w", tierProof.Tier, err)
 	}
+	tierProof.Data = data
 
-	// Only check for safety.
-	if method.Name != "proposeBlock" {
-		return nil, fmt.Errorf("invalid method name: %s", method.Name)
+	fork, ok := metadata.ForFork(meta.ForkVersion())
+	if !ok {
+		return nil, fmt.Errorf("no fork registered for version %d", meta.ForkVersion())
 	}
 
-	args := map[string]interface{}{}
+	return fork.PackProveInput(meta, transition, tierProof)
+}
+
+// UnpackTxListBytes unpacks the input data of a TaikoL1.proposeBlock transaction, and returns the
+// txList bytes. It is a thin wrapper around DecodeProposeBlockCalldata for callers that only want
+// the txList; the returned slice is a view into txData, not a copy.
+func UnpackTxListBytes(txData []byte) ([]byte, error) {
+	var txList []byte
 
-	if err := method.Inputs.UnpackIntoMap(args, txData[4:]); err != nil {
+	if err := DecodeProposeBlockCalldata(txData, func(field string, rawOffset, rawLen int) error {
+		if field == "_txList" {
+			txList = txData[rawOffset : rawOffset+rawLen]
+		}
+		return nil
+	}); err != nil {
 		return nil, err
 	}
 
-	inputs, ok := args["_txList"].([]byte)
-
-	if !ok {
+	if txList == nil {
 		return nil, errors.New("failed to get txList bytes")
 	}
 
-	return inputs, nil
+	return txList, nil
 }
@@ -0,0 +1,137 @@
+package encoding
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// blockParamsPreconfComponents mirrors blockParams2Components with an added proposer signature
+// commitment, so a preconfirmed block's params can be proposed on L1 with proof that they match
+// what the proposer soft-committed to.
+var blockParamsPreconfComponents = append(
+	append([]abi.ArgumentMarshaling{}, blockParams2Components...),
+	abi.ArgumentMarshaling{
+		Name: "preconfSignature",
+		Type: "bytes",
+	},
+)
+
+var (
+	blockParamsPreconfType, _ = abi.NewType("tuple", "TaikoData.BlockParamsPreconf", blockParamsPreconfComponents)
+	blockParamsPreconfArgs    = abi.Arguments{{Name: "TaikoData.BlockParamsPreconf", Type: blockParamsPreconfType}}
+)
+
+// EncodeBlockParamsPreconf performs the solidity `abi.encode` for the given preconfirmed
+// blockParams, which carry the proposer's soft-commit signature alongside the usual ontake
+// block params.
+func EncodeBlockParamsPreconf(params *BlockParamsPreconf) ([]byte, error) {
+	b, err := blockParamsPreconfArgs.Pack(params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to abi.encode preconf block params, %w", err)
+	}
+	return b, nil
+}
+
+// BlockParamsPreconf is BlockParams2 plus the proposer's soft-commit signature over the
+// corresponding PreconfPayload.
+type BlockParamsPreconf struct {
+	BlockParams2
+	PreconfSignature []byte `abi:"preconfSignature"`
+}
+
+// PreconfPayload is the payload a proposer signs off on when soft-committing to a block before
+// it has been proposed on L1, see `TaikoDataBlockMetadataPreconf` in the `metadata` package.
+type PreconfPayload struct {
+	BlockID    uint64      `abi:"blockId"`
+	ParentHash common.Hash `abi:"parentHash"`
+	TxListHash common.Hash `abi:"txListHash"`
+	Timestamp  uint64      `abi:"timestamp"`
+	Nonce      uint64      `abi:"nonce"`
+}
+
+// ABI arguments marshaling components.
+var preconfPayloadComponents = []abi.ArgumentMarshaling{
+	{
+		Name: "blockId",
+		Type: "uint64",
+	},
+	{
+		Name: "parentHash",
+		Type: "bytes32",
+	},
+	{
+		Name: "txListHash",
+		Type: "bytes32",
+	},
+	{
+		Name: "timestamp",
+		Type: "uint64",
+	},
+	{
+		Name: "nonce",
+		Type: "uint64",
+	},
+}
+
+var (
+	preconfPayloadType, _ = abi.NewType("tuple", "PreconfPayload", preconfPayloadComponents)
+	preconfPayloadArgs    = abi.Arguments{{Name: "PreconfPayload", Type: preconfPayloadType}}
+)
+
+// preconfDomainSeparator derives the EIP-712-style domain separator that binds a preconfirmation
+// signature to a specific TaikoL1 deployment, so a signature cannot be replayed across chains or
+// against a different TaikoL1 instance.
+func preconfDomainSeparator(chainID uint64, taikoL1Address common.Address) common.Hash {
+	return crypto.Keccak256Hash(
+		[]byte("TAIKO_PRECONF_DOMAIN"),
+		common.LeftPadBytes(new(big.Int).SetUint64(chainID).Bytes(), 32),
+		taikoL1Address.Bytes(),
+	)
+}
+
+// EncodePreconfPayload performs the solidity `abi.encode` for the given preconfirmation payload.
+func EncodePreconfPayload(payload *PreconfPayload) ([]byte, error) {
+	b, err := preconfPayloadArgs.Pack(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to abi.encode preconf payload, %w", err)
+	}
+	return b, nil
+}
+
+// HashPreconfPayload returns the EIP-712-style digest a proposer signs to soft-commit to the
+// given preconfirmation payload on behalf of the given TaikoL1 deployment.
+func HashPreconfPayload(payload *PreconfPayload, chainID uint64, taikoL1Address common.Address) (common.Hash, error) {
+	encoded, err := EncodePreconfPayload(payload)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	domainSeparator := preconfDomainSeparator(chainID, taikoL1Address)
+
+	return crypto.Keccak256Hash(domainSeparator.Bytes(), encoded), nil
+}
+
+// VerifyPreconfSignature recovers the signer of the given preconfirmation payload signature, and
+// returns an error if the signature is malformed.
+func VerifyPreconfSignature(
+	payload *PreconfPayload,
+	chainID uint64,
+	taikoL1Address common.Address,
+	sig []byte,
+) (common.Address, error) {
+	digest, err := HashPreconfPayload(payload, chainID, taikoL1Address)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to hash preconf payload, %w", err)
+	}
+
+	pubKey, err := crypto.SigToPub(digest.Bytes(), sig)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to recover preconf signature, %w", err)
+	}
+
+	return crypto.PubkeyToAddress(*pubKey), nil
+}
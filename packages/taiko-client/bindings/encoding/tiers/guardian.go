@@ -0,0 +1,71 @@
+package tiers
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// TierGuardianID is the on-chain tier ID of the Guardian verifier tier.
+const TierGuardianID uint16 = 900
+
+// GuardianProofData is the proof data a Guardian prover submits: a multisig bundle of guardian
+// signatures over the proven transition.
+type GuardianProofData struct {
+	Signatures [][]byte
+}
+
+// guardianProofDataArgs mirrors the flattened top-level Arguments convention used elsewhere in
+// this package (e.g. TaikoL1ABI method inputs): the field is its own argument rather than a
+// single wrapping tuple, so Unpack returns it directly instead of requiring a struct tag match
+// that UnpackIntoMap can't make for an unnamed tuple.
+var (
+	guardianBytesArrayType, _ = abi.NewType("bytes[]", "", nil)
+	guardianProofDataArgs     = abi.Arguments{{Name: "signatures", Type: guardianBytesArrayType}}
+)
+
+// guardianTierCodec implements TierCodec for the Guardian verifier tier.
+type guardianTierCodec struct{}
+
+// VerifierName implements the TierCodec interface.
+func (guardianTierCodec) VerifierName() [32]byte {
+	return [32]byte(common.RightPadBytes([]byte("TIER_GUARDIAN"), 32))
+}
+
+// EncodeProofData implements the TierCodec interface.
+func (guardianTierCodec) EncodeProofData(proof any) ([]byte, error) {
+	guardianProof, ok := proof.(*GuardianProofData)
+	if !ok {
+		return nil, fmt.Errorf("expected *GuardianProofData, got %T", proof)
+	}
+
+	b, err := guardianProofDataArgs.Pack(guardianProof.Signatures)
+	if err != nil {
+		return nil, fmt.Errorf("failed to abi.encode Guardian proof data, %w", err)
+	}
+
+	return b, nil
+}
+
+// DecodeProofData implements the TierCodec interface.
+func (guardianTierCodec) DecodeProofData(data []byte) (any, error) {
+	values, err := guardianProofDataArgs.Unpack(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to abi.decode Guardian proof data, %w", err)
+	}
+	if len(values) != 1 {
+		return nil, fmt.Errorf("expected 1 decoded Guardian proof data value, got %d", len(values))
+	}
+
+	signatures, ok := values[0].([][]byte)
+	if !ok {
+		return nil, fmt.Errorf("expected [][]byte for signatures, got %T", values[0])
+	}
+
+	return &GuardianProofData{Signatures: signatures}, nil
+}
+
+func init() {
+	RegisterTier(TierGuardianID, guardianTierCodec{})
+}
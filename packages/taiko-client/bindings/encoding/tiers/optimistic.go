@@ -0,0 +1,29 @@
+package tiers
+
+import "github.com/ethereum/go-ethereum/common"
+
+// TierOptimisticID is the on-chain tier ID of the Optimistic tier, which requires no proof data
+// at all and relies purely on the liveness bond and proving window.
+const TierOptimisticID uint16 = 0
+
+// optimisticTierCodec implements TierCodec for the Optimistic tier.
+type optimisticTierCodec struct{}
+
+// VerifierName implements the TierCodec interface.
+func (optimisticTierCodec) VerifierName() [32]byte {
+	return [32]byte(common.RightPadBytes([]byte("TIER_OPTIMISTIC"), 32))
+}
+
+// EncodeProofData implements the TierCodec interface. The optimistic tier carries no proof data.
+func (optimisticTierCodec) EncodeProofData(any) ([]byte, error) {
+	return []byte{}, nil
+}
+
+// DecodeProofData implements the TierCodec interface.
+func (optimisticTierCodec) DecodeProofData([]byte) (any, error) {
+	return nil, nil
+}
+
+func init() {
+	RegisterTier(TierOptimisticID, optimisticTierCodec{})
+}
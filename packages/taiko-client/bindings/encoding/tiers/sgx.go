@@ -0,0 +1,81 @@
+package tiers
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// TierSGXID is the on-chain tier ID of the SGX verifier tier.
+const TierSGXID uint16 = 100
+
+// SGXProofData is the proof data an SGX prover submits: the new TEE instance address it is
+// rotating to, signed by the current instance's attestation key.
+type SGXProofData struct {
+	NewInstance common.Address
+	Signature   []byte
+}
+
+// sgxProofDataArgs mirrors the flattened top-level Arguments convention used elsewhere in this
+// package (e.g. TaikoL1ABI method inputs): each field is its own argument rather than a single
+// wrapping tuple, so Unpack returns the fields directly instead of requiring a struct tag match
+// that UnpackIntoMap can't make for an unnamed tuple.
+var (
+	sgxAddressType, _ = abi.NewType("address", "", nil)
+	sgxBytesType, _   = abi.NewType("bytes", "", nil)
+	sgxProofDataArgs  = abi.Arguments{
+		{Name: "newInstance", Type: sgxAddressType},
+		{Name: "signature", Type: sgxBytesType},
+	}
+)
+
+// sgxTierCodec implements TierCodec for the SGX verifier tier.
+type sgxTierCodec struct{}
+
+// VerifierName implements the TierCodec interface.
+func (sgxTierCodec) VerifierName() [32]byte {
+	return [32]byte(common.RightPadBytes([]byte("TIER_SGX"), 32))
+}
+
+// EncodeProofData implements the TierCodec interface.
+func (sgxTierCodec) EncodeProofData(proof any) ([]byte, error) {
+	sgxProof, ok := proof.(*SGXProofData)
+	if !ok {
+		return nil, fmt.Errorf("expected *SGXProofData, got %T", proof)
+	}
+
+	b, err := sgxProofDataArgs.Pack(sgxProof.NewInstance, sgxProof.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("failed to abi.encode SGX proof data, %w", err)
+	}
+
+	return b, nil
+}
+
+// DecodeProofData implements the TierCodec interface.
+func (sgxTierCodec) DecodeProofData(data []byte) (any, error) {
+	values, err := sgxProofDataArgs.Unpack(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to abi.decode SGX proof data, %w", err)
+	}
+	if len(values) != 2 {
+		return nil, fmt.Errorf("expected 2 decoded SGX proof data values, got %d", len(values))
+	}
+
+	newInstance, ok := values[0].(common.Address)
+	if !ok {
+		return nil, fmt.Errorf("expected common.Address for newInstance, got %T", values[0])
+	}
+
+	signature, ok := values[1].([]byte)
+	if !ok {
+		return nil, fmt.Errorf("expected []byte for signature, got %T", values[1])
+	}
+
+	return &SGXProofData{NewInstance: newInstance, Signature: signature}, nil
+}
+
+func init() {
+	RegisterTier(TierSGXID, sgxTierCodec{})
+}
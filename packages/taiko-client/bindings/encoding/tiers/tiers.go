@@ -0,0 +1,78 @@
+// Package tiers provides per-verifier-tier encoding of the `data` field of a
+// TaikoData.TierProof, since each tier (SGX, ZK, Guardian, Optimistic, ...) expects a
+// differently-structured proof blob.
+package tiers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings"
+)
+
+// TierCodec knows how to encode and decode the `data` field of a TaikoData.TierProof for one
+// specific verifier tier.
+type TierCodec interface {
+	// EncodeProofData packs the tier-specific proof into the bytes expected in TierProof.Data.
+	EncodeProofData(proof any) ([]byte, error)
+	// DecodeProofData unpacks TierProof.Data into the tier-specific proof representation.
+	DecodeProofData(data []byte) (any, error)
+	// VerifierName returns the name of the on-chain verifier contract for this tier, as returned
+	// by ITierProvider.getTier().verifierName.
+	VerifierName() [32]byte
+}
+
+var registry = map[uint16]TierCodec{}
+
+// RegisterTier registers a TierCodec under its on-chain tier ID, overwriting any codec
+// previously registered for that ID.
+func RegisterTier(tierID uint16, codec TierCodec) {
+	registry[tierID] = codec
+}
+
+// Get returns the TierCodec registered for the given tier ID, and whether one was found.
+func Get(tierID uint16) (TierCodec, bool) {
+	codec, ok := registry[tierID]
+	return codec, ok
+}
+
+// TierProvider is the subset of the ITierProvider contract binding that LoadFromChain needs.
+type TierProvider interface {
+	GetTierIds(opts *bind.CallOpts) ([]uint16, error)
+	GetTier(opts *bind.CallOpts, tierID uint16) (bindings.ITierProviderTier, error)
+}
+
+// LoadFromChain reads every tier ID the given ITierProvider serves, and returns an error if any
+// of them has no TierCodec registered locally, or if the on-chain verifier for a known tier ID
+// doesn't match the verifier the locally registered TierCodec was written against, so an unknown
+// tier or a tier/verifier mismatch discovered on-chain is rejected at startup rather than silently
+// mis-encoded later.
+func LoadFromChain(ctx context.Context, provider TierProvider) error {
+	ids, err := provider.GetTierIds(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return fmt.Errorf("failed to get tier ids from ITierProvider, %w", err)
+	}
+
+	for _, id := range ids {
+		codec, ok := Get(id)
+		if !ok {
+			return fmt.Errorf("no TierCodec registered for on-chain tier ID %d", id)
+		}
+
+		tier, err := provider.GetTier(&bind.CallOpts{Context: ctx}, id)
+		if err != nil {
+			return fmt.Errorf("failed to get tier %d from ITierProvider, %w", id, err)
+		}
+
+		if tier.VerifierName != codec.VerifierName() {
+			return fmt.Errorf(
+				"on-chain verifier %x for tier ID %d does not match registered TierCodec verifier %x",
+				tier.VerifierName, id, codec.VerifierName(),
+			)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,117 @@
+package tiers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings"
+)
+
+func TestSGXProofDataRoundTrip(t *testing.T) {
+	codec, ok := Get(TierSGXID)
+	require.True(t, ok)
+
+	want := &SGXProofData{
+		NewInstance: common.HexToAddress("0x00000000000000000000000000000000000AbC"),
+		Signature:   []byte{1, 2, 3},
+	}
+
+	encoded, err := codec.EncodeProofData(want)
+	require.NoError(t, err)
+
+	decoded, err := codec.DecodeProofData(encoded)
+	require.NoError(t, err)
+	require.Equal(t, want, decoded)
+}
+
+func TestGuardianProofDataRoundTrip(t *testing.T) {
+	codec, ok := Get(TierGuardianID)
+	require.True(t, ok)
+
+	want := &GuardianProofData{Signatures: [][]byte{{1, 2, 3}, {4, 5, 6}}}
+
+	encoded, err := codec.EncodeProofData(want)
+	require.NoError(t, err)
+
+	decoded, err := codec.DecodeProofData(encoded)
+	require.NoError(t, err)
+	require.Equal(t, want, decoded)
+}
+
+func TestZKProofDataRoundTrip(t *testing.T) {
+	codec, ok := Get(TierZKID)
+	require.True(t, ok)
+
+	want := ZKProofData([]byte{0xde, 0xad, 0xbe, 0xef})
+
+	encoded, err := codec.EncodeProofData(want)
+	require.NoError(t, err)
+
+	decoded, err := codec.DecodeProofData(encoded)
+	require.NoError(t, err)
+	require.Equal(t, want, decoded)
+}
+
+func TestOptimisticProofDataRoundTrip(t *testing.T) {
+	codec, ok := Get(TierOptimisticID)
+	require.True(t, ok)
+
+	encoded, err := codec.EncodeProofData(nil)
+	require.NoError(t, err)
+	require.Empty(t, encoded)
+
+	decoded, err := codec.DecodeProofData(encoded)
+	require.NoError(t, err)
+	require.Nil(t, decoded)
+}
+
+func TestLoadFromChain_UnknownTierRejected(t *testing.T) {
+	provider := &stubTierProvider{ids: []uint16{TierSGXID, 12345}}
+
+	err := LoadFromChain(context.Background(), provider)
+	require.ErrorContains(t, err, "12345")
+}
+
+func TestLoadFromChain_AllKnownTiers(t *testing.T) {
+	provider := &stubTierProvider{ids: []uint16{TierOptimisticID, TierSGXID, TierZKID, TierGuardianID}}
+
+	require.NoError(t, LoadFromChain(context.Background(), provider))
+}
+
+func TestLoadFromChain_VerifierNameMismatchRejected(t *testing.T) {
+	provider := &stubTierProvider{
+		ids:           []uint16{TierSGXID},
+		verifierNames: map[uint16][32]byte{TierSGXID: {0xde, 0xad}},
+	}
+
+	err := LoadFromChain(context.Background(), provider)
+	require.ErrorContains(t, err, "does not match")
+}
+
+// stubTierProvider is a minimal TierProvider for testing LoadFromChain without a live chain. If
+// verifierNames has no entry for a given tier ID, GetTier reports the registered TierCodec's own
+// verifier name, so a test that doesn't care about the verifier name check still passes it.
+type stubTierProvider struct {
+	ids           []uint16
+	verifierNames map[uint16][32]byte
+}
+
+func (s *stubTierProvider) GetTierIds(*bind.CallOpts) ([]uint16, error) {
+	return s.ids, nil
+}
+
+func (s *stubTierProvider) GetTier(_ *bind.CallOpts, tierID uint16) (bindings.ITierProviderTier, error) {
+	if name, ok := s.verifierNames[tierID]; ok {
+		return bindings.ITierProviderTier{VerifierName: name}, nil
+	}
+
+	if codec, ok := Get(tierID); ok {
+		return bindings.ITierProviderTier{VerifierName: codec.VerifierName()}, nil
+	}
+
+	return bindings.ITierProviderTier{}, nil
+}
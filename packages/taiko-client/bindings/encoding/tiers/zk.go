@@ -0,0 +1,42 @@
+package tiers
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// TierZKID is the on-chain tier ID of the ZK verifier tier.
+const TierZKID uint16 = 200
+
+// ZKProofData is the proof data a ZK prover submits: the raw SNARK/STARK proof bytes, passed to
+// the on-chain verifier untouched.
+type ZKProofData []byte
+
+// zkTierCodec implements TierCodec for the ZK verifier tier. Unlike SGX and Guardian, the ZK
+// proof has no ABI-encoded structure of its own, so it is passed through as-is.
+type zkTierCodec struct{}
+
+// VerifierName implements the TierCodec interface.
+func (zkTierCodec) VerifierName() [32]byte {
+	return [32]byte(common.RightPadBytes([]byte("TIER_ZK"), 32))
+}
+
+// EncodeProofData implements the TierCodec interface.
+func (zkTierCodec) EncodeProofData(proof any) ([]byte, error) {
+	zkProof, ok := proof.(ZKProofData)
+	if !ok {
+		return nil, fmt.Errorf("expected ZKProofData, got %T", proof)
+	}
+
+	return zkProof, nil
+}
+
+// DecodeProofData implements the TierCodec interface.
+func (zkTierCodec) DecodeProofData(data []byte) (any, error) {
+	return ZKProofData(data), nil
+}
+
+func init() {
+	RegisterTier(TierZKID, zkTierCodec{})
+}
@@ -0,0 +1,44 @@
+package metadata
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// BlobResolver resolves the tx list bytes carried in an EIP-4844 blob for a given block's
+// metadata, verifying the blob contents against the versioned hash committed to on L1.
+type BlobResolver interface {
+	// Resolve returns the raw txList bytes referenced by meta's blob hash / offset / length.
+	Resolve(ctx context.Context, meta TaikoBlockMetaData) (txList []byte, err error)
+}
+
+// blobVersionedHash derives the EIP-4844 versioned hash for the given KZG commitment: the
+// SHA-256 digest of the commitment, with its first byte replaced by the blob version byte.
+func blobVersionedHash(commitment []byte) common.Hash {
+	digest := sha256.Sum256(commitment)
+	digest[0] = 0x01
+	return common.Hash(digest)
+}
+
+// verifyBlobTxList checks that the sidecar's KZG commitment hashes to meta's blob versioned
+// hash, then slices out meta's txList from the sidecar's blob.
+//
+// The bounds check below compares via subtraction rather than adding offset+length, since both
+// come straight off L1 block metadata and an addition-based check can silently wrap around and
+// pass for a huge offset/length, the same approach the encoding package's decodeDynamicBytesArg
+// takes for calldata offsets.
+func verifyBlobTxList(meta TaikoBlockMetaData, commitment []byte, blob []byte) ([]byte, error) {
+	if got, want := blobVersionedHash(commitment), meta.GetBlobHash(); got != want {
+		return nil, fmt.Errorf("blob versioned hash mismatch: got %s, want %s", got, want)
+	}
+
+	blobLen, offset, length := uint32(len(blob)), meta.GetBlobTxListOffset(), meta.GetBlobTxListLength()
+	if offset > blobLen || blobLen-offset < length {
+		return nil, fmt.Errorf("blob too short: have %d bytes, need offset %d + length %d", blobLen, offset, length)
+	}
+
+	return blob[offset : offset+length], nil
+}
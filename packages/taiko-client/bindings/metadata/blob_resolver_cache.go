@@ -0,0 +1,44 @@
+package metadata
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// cacheBlobResolver is a BlobResolver backed by a local in-memory cache of already-resolved blob
+// contents, keyed by blob versioned hash, so re-resolving the same block (e.g. while the driver
+// handles an L1 reorg) doesn't require refetching from L1 / the beacon node.
+type cacheBlobResolver struct {
+	mutex sync.RWMutex
+	cache map[common.Hash][]byte
+}
+
+// NewCacheBlobResolver creates a new instance of a local in-memory cache BlobResolver.
+func NewCacheBlobResolver() BlobResolver {
+	return &cacheBlobResolver{cache: make(map[common.Hash][]byte)}
+}
+
+// Resolve implements the BlobResolver interface.
+func (r *cacheBlobResolver) Resolve(_ context.Context, meta TaikoBlockMetaData) ([]byte, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	txList, ok := r.cache[meta.GetBlobHash()]
+	if !ok {
+		return nil, fmt.Errorf("no cached txList for blob hash %s", meta.GetBlobHash())
+	}
+
+	return txList, nil
+}
+
+// Put stores the already-verified txList bytes for the given blob hash, for later Resolve calls
+// to return without hitting the network again.
+func (r *cacheBlobResolver) Put(blobHash common.Hash, txList []byte) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.cache[blobHash] = txList
+}
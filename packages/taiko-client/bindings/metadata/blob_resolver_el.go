@@ -0,0 +1,90 @@
+package metadata
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// ELClient is the subset of an execution-layer client elBlobResolver needs to locate the type-3
+// transaction carrying a block's blob versioned hashes.
+type ELClient interface {
+	BlockByHash(ctx context.Context, hash common.Hash) (*types.Block, error)
+}
+
+// BeaconBlobSidecar is a single blob sidecar as returned by the beacon
+// `/eth/v1/beacon/blob_sidecars/{slot}` endpoint.
+type BeaconBlobSidecar struct {
+	Index         uint64
+	KZGCommitment []byte
+	Blob          []byte
+}
+
+// BeaconClient is the subset of a beacon node client elBlobResolver needs to fetch blob
+// sidecars.
+type BeaconClient interface {
+	BlobSidecars(ctx context.Context, slot uint64) ([]BeaconBlobSidecar, error)
+}
+
+// SlotForTimestampFunc converts an L1 block's timestamp to the beacon slot it was included in.
+type SlotForTimestampFunc func(l1BlockTimestamp uint64) uint64
+
+// elBlobResolver is a BlobResolver backed by an execution-layer client and a beacon node, used to
+// fetch and verify a block's blob contents directly from L1.
+type elBlobResolver struct {
+	el           ELClient
+	beacon       BeaconClient
+	slotForBlock SlotForTimestampFunc
+}
+
+// NewELBlobResolver creates a new instance of a BlobResolver backed by the given execution-layer
+// and beacon clients.
+func NewELBlobResolver(el ELClient, beacon BeaconClient, slotForBlock SlotForTimestampFunc) BlobResolver {
+	return &elBlobResolver{el: el, beacon: beacon, slotForBlock: slotForBlock}
+}
+
+// Resolve implements the BlobResolver interface.
+func (r *elBlobResolver) Resolve(ctx context.Context, meta TaikoBlockMetaData) ([]byte, error) {
+	block, err := r.el.BlockByHash(ctx, meta.GetRawBlockHash())
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch L1 block %s, %w", meta.GetRawBlockHash(), err)
+	}
+
+	var blobTx *types.Transaction
+	for _, tx := range block.Transactions() {
+		hashes := tx.BlobHashes()
+		if int(meta.GetBlobIndex()) < len(hashes) && hashes[meta.GetBlobIndex()] == meta.GetBlobHash() {
+			blobTx = tx
+			break
+		}
+	}
+	if blobTx == nil {
+		return nil, fmt.Errorf(
+			"no type-3 transaction carrying blob hash %s found in block %s",
+			meta.GetBlobHash(),
+			meta.GetRawBlockHash(),
+		)
+	}
+
+	sidecars, err := r.beacon.BlobSidecars(ctx, r.slotForBlock(block.Time()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch blob sidecars for L1 block %s, %w", meta.GetRawBlockHash(), err)
+	}
+
+	// meta.GetBlobIndex() is the blob's index within blobTx's own blob list, not its index within
+	// the slot (sidecar.Index is block-wide across every blob-carrying transaction in the slot),
+	// so the two can't be compared directly. Instead, authenticate each sidecar by recomputing
+	// its versioned hash and trust the first one that matches meta's blob hash.
+	for _, sidecar := range sidecars {
+		txList, err := verifyBlobTxList(meta, sidecar.KZGCommitment, sidecar.Blob)
+		if err != nil {
+			continue
+		}
+
+		return txList, nil
+	}
+
+	return nil, fmt.Errorf("no blob sidecar matching blob hash %s found for L1 block %s", meta.GetBlobHash(), meta.GetRawBlockHash())
+}
@@ -0,0 +1,81 @@
+package metadata
+
+import (
+	"crypto/sha256"
+	"math"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+// stubBlobMeta is a minimal TaikoBlockMetaData stand-in that only fills in the fields
+// verifyBlobTxList reads.
+type stubBlobMeta struct {
+	TaikoDataBlockMetadataOntake
+	blobHash common.Hash
+	offset   uint32
+	length   uint32
+}
+
+func (m *stubBlobMeta) GetBlobHash() common.Hash    { return m.blobHash }
+func (m *stubBlobMeta) GetBlobTxListOffset() uint32 { return m.offset }
+func (m *stubBlobMeta) GetBlobTxListLength() uint32 { return m.length }
+
+func versionedHashFor(commitment []byte) common.Hash {
+	digest := sha256.Sum256(commitment)
+	digest[0] = 0x01
+	return common.Hash(digest)
+}
+
+func TestVerifyBlobTxList_Valid(t *testing.T) {
+	commitment := []byte("fake-kzg-commitment")
+	blob := append([]byte("padding-before-"), []byte("the-tx-list-bytes")...)
+
+	meta := &stubBlobMeta{
+		blobHash: versionedHashFor(commitment),
+		offset:   uint32(len("padding-before-")),
+		length:   uint32(len("the-tx-list-bytes")),
+	}
+
+	txList, err := verifyBlobTxList(meta, commitment, blob)
+	require.NoError(t, err)
+	require.Equal(t, []byte("the-tx-list-bytes"), txList)
+}
+
+func TestVerifyBlobTxList_CommitmentMismatch(t *testing.T) {
+	meta := &stubBlobMeta{blobHash: common.Hash{0xaa}, offset: 0, length: 4}
+
+	_, err := verifyBlobTxList(meta, []byte("wrong-commitment"), []byte("blob"))
+	require.ErrorContains(t, err, "mismatch")
+}
+
+func TestVerifyBlobTxList_RejectsOffsetLengthOverflow(t *testing.T) {
+	commitment := []byte("fake-kzg-commitment")
+	blob := []byte("short-blob")
+
+	// offset + length overflows uint32 if computed via unchecked addition, which would
+	// otherwise wrap past blobLen and pass an addition-based bounds check.
+	meta := &stubBlobMeta{
+		blobHash: versionedHashFor(commitment),
+		offset:   math.MaxUint32 - 2,
+		length:   10,
+	}
+
+	_, err := verifyBlobTxList(meta, commitment, blob)
+	require.ErrorContains(t, err, "too short")
+}
+
+func TestVerifyBlobTxList_RejectsTruncatedBlob(t *testing.T) {
+	commitment := []byte("fake-kzg-commitment")
+	blob := []byte("short")
+
+	meta := &stubBlobMeta{
+		blobHash: versionedHashFor(commitment),
+		offset:   0,
+		length:   100,
+	}
+
+	_, err := verifyBlobTxList(meta, commitment, blob)
+	require.ErrorContains(t, err, "too short")
+}
@@ -0,0 +1,58 @@
+package metadata
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings"
+)
+
+// ForkVersion identifies a Taiko protocol fork that defines its own block metadata shape.
+type ForkVersion uint8
+
+const (
+	ForkLegacy ForkVersion = iota
+	ForkOntake
+	ForkPreconf
+)
+
+// Fork describes everything fork-specific about a Taiko protocol block: how to build its
+// metadata from the fork's own `BlockProposed*` event, the ABI arguments used to pack/unpack its
+// TaikoL1.proveBlock input, and how to pack/unpack the inner metadata struct that input carries.
+// A future fork (e.g. Pacaya/Hekla) registers its own Fork implementation via RegisterFork and
+// needs no changes to the encoder or to driver/proposer/prover call sites, which only ever go
+// through ForFork.
+type Fork interface {
+	// Version returns the fork version this implementation handles.
+	Version() ForkVersion
+	// NewMetadata builds a TaikoBlockMetaData from the fork's own `BlockProposed*` event. event
+	// must be the concrete event type this fork expects (e.g. *bindings.LibProposingBlockProposed2
+	// for ForkOntake); any other type is a programmer error and returns an error.
+	NewMetadata(event any) (TaikoBlockMetaData, error)
+	// ProveInputArgs returns the ABI arguments used to pack/unpack this fork's
+	// TaikoL1.proveBlock input.
+	ProveInputArgs() abi.Arguments
+	// PackProveInput performs the solidity `abi.encode` for the given TaikoL1.proveBlock input.
+	PackProveInput(
+		meta TaikoBlockMetaData,
+		transition *bindings.TaikoDataTransition,
+		tierProof *bindings.TaikoDataTierProof,
+	) ([]byte, error)
+	// UnpackInnerMetadata decodes this fork's ABI-encoded inner metadata struct out of raw
+	// TaikoL1.proveBlock input bytes.
+	UnpackInnerMetadata(data []byte) (any, error)
+}
+
+var forks = map[ForkVersion]Fork{}
+
+// RegisterFork registers a Fork implementation under its ForkVersion, overwriting any
+// implementation previously registered for that version.
+func RegisterFork(f Fork) {
+	forks[f.Version()] = f
+}
+
+// ForFork returns the Fork implementation registered for the given ForkVersion, and whether one
+// was found.
+func ForFork(v ForkVersion) (Fork, bool) {
+	f, ok := forks[v]
+	return f, ok
+}
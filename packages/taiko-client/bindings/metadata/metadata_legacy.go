@@ -0,0 +1,6 @@
+package metadata
+
+// ForkVersion returns the fork version this metadata belongs to.
+func (m *TaikoDataBlockMetadataLegacy) ForkVersion() ForkVersion {
+	return ForkLegacy
+}
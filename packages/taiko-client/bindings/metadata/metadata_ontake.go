@@ -156,3 +156,8 @@ func (m *TaikoDataBlockMetadataOntake) GetTxHash() common.Hash {
 func (m *TaikoDataBlockMetadataOntake) IsOntakeBlock() bool {
 	return true
 }
+
+// ForkVersion returns the fork version this metadata belongs to.
+func (m *TaikoDataBlockMetadataOntake) ForkVersion() ForkVersion {
+	return ForkOntake
+}
@@ -0,0 +1,205 @@
+package metadata
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Ensure TaikoDataBlockMetadataPreconf implements TaikoBlockMetaData.
+var _ TaikoBlockMetaData = (*TaikoDataBlockMetadataPreconf)(nil)
+
+// TaikoDataBlockMetadataPreconf is the metadata of a preconfirmed Taiko block, received over the
+// proposer p2p/gossip network before the corresponding `TaikoL1.BlockProposed2` event has landed
+// on L1. Once that event arrives, the driver reconciles it against this metadata and a regular
+// `TaikoDataBlockMetadataOntake` takes over.
+type TaikoDataBlockMetadataPreconf struct {
+	BlockID       uint64
+	ParentHashVal common.Hash
+	TxListHashVal common.Hash
+	TimestampVal  uint64
+	Nonce         uint64
+	ProposerVal   common.Address
+	PreconfSigVal []byte
+}
+
+// NewTaikoDataBlockMetadataPreconf creates a new instance of TaikoDataBlockMetadataPreconf from a
+// soft-commit received over the preconfirmation gossip network.
+func NewTaikoDataBlockMetadataPreconf(
+	blockID uint64,
+	parentHash common.Hash,
+	txListHash common.Hash,
+	timestamp uint64,
+	nonce uint64,
+	proposer common.Address,
+	preconfSignature []byte,
+) *TaikoDataBlockMetadataPreconf {
+	return &TaikoDataBlockMetadataPreconf{
+		BlockID:       blockID,
+		ParentHashVal: parentHash,
+		TxListHashVal: txListHash,
+		TimestampVal:  timestamp,
+		Nonce:         nonce,
+		ProposerVal:   proposer,
+		PreconfSigVal: preconfSignature,
+	}
+}
+
+// GetBlockID returns the L2 block ID.
+func (m *TaikoDataBlockMetadataPreconf) GetBlockID() *big.Int {
+	return new(big.Int).SetUint64(m.BlockID)
+}
+
+// GetParentMetaHash returns the parent block hash the proposer soft-committed to, used here as a
+// stand-in for the parent meta hash until the block is proposed on L1 and a real parent meta hash
+// is assigned.
+func (m *TaikoDataBlockMetadataPreconf) GetParentMetaHash() common.Hash {
+	return m.ParentHashVal
+}
+
+// GetTimestamp returns the timestamp the proposer soft-committed to.
+func (m *TaikoDataBlockMetadataPreconf) GetTimestamp() uint64 {
+	return m.TimestampVal
+}
+
+// GetProposer returns the proposer address.
+func (m *TaikoDataBlockMetadataPreconf) GetProposer() common.Address {
+	return m.ProposerVal
+}
+
+// GetAssignedProver returns the assigned prover address, right now this address should be equal
+// to the proposer address.
+func (m *TaikoDataBlockMetadataPreconf) GetAssignedProver() common.Address {
+	return m.ProposerVal
+}
+
+// GetRawBlockHeight returns zero, since a preconfirmed block has not yet been included in an L1
+// block; callers must check IsPreconfirmed() before relying on raw block location fields.
+func (m *TaikoDataBlockMetadataPreconf) GetRawBlockHeight() *big.Int {
+	return common.Big0
+}
+
+// GetRawBlockHash returns the zero hash, for the same reason as GetRawBlockHeight.
+func (m *TaikoDataBlockMetadataPreconf) GetRawBlockHash() common.Hash {
+	return common.Hash{}
+}
+
+// GetAnchorBlockHash returns the zero hash, since the anchor block is only selected once the
+// block is actually proposed on L1.
+func (m *TaikoDataBlockMetadataPreconf) GetAnchorBlockHash() common.Hash {
+	return common.Hash{}
+}
+
+// GetDifficulty returns the zero hash, since difficulty is derived at proposal time.
+func (m *TaikoDataBlockMetadataPreconf) GetDifficulty() common.Hash {
+	return common.Hash{}
+}
+
+// GetBlobHash returns the soft-committed tx list hash, used as a stand-in for the blob hash until
+// the block is proposed on L1 and the real blob versioned hash is known.
+func (m *TaikoDataBlockMetadataPreconf) GetBlobHash() common.Hash {
+	return m.TxListHashVal
+}
+
+// GetExtraData returns nil, since extra data is only set at proposal time.
+func (m *TaikoDataBlockMetadataPreconf) GetExtraData() []byte {
+	return nil
+}
+
+// GetCoinbase returns the proposer address, used as the coinbase until the block is proposed.
+func (m *TaikoDataBlockMetadataPreconf) GetCoinbase() common.Address {
+	return m.ProposerVal
+}
+
+// GetGasLimit returns zero, since the gas limit is only set at proposal time.
+func (m *TaikoDataBlockMetadataPreconf) GetGasLimit() uint32 {
+	return 0
+}
+
+// GetAnchorBlockID returns zero, since the anchor block is only selected once the block is
+// actually proposed on L1.
+func (m *TaikoDataBlockMetadataPreconf) GetAnchorBlockID() uint64 {
+	return 0
+}
+
+// GetMinTier returns zero, since the minimum tier is only assigned at proposal time.
+func (m *TaikoDataBlockMetadataPreconf) GetMinTier() uint16 {
+	return 0
+}
+
+// GetBlobUsed returns false, since a preconfirmed block carries its tx list inline over gossip
+// rather than in a blob.
+func (m *TaikoDataBlockMetadataPreconf) GetBlobUsed() bool {
+	return false
+}
+
+// GetLivenessBond returns zero, since the liveness bond is only locked at proposal time.
+func (m *TaikoDataBlockMetadataPreconf) GetLivenessBond() *big.Int {
+	return common.Big0
+}
+
+// GetProposedAt returns the timestamp the proposer soft-committed to.
+func (m *TaikoDataBlockMetadataPreconf) GetProposedAt() uint64 {
+	return m.TimestampVal
+}
+
+// GetProposedIn returns zero, since the proposal's L1 block number isn't known yet.
+func (m *TaikoDataBlockMetadataPreconf) GetProposedIn() uint64 {
+	return 0
+}
+
+// GetBlobTxListOffset returns zero, since a preconfirmed block carries its tx list inline over
+// gossip rather than in a blob.
+func (m *TaikoDataBlockMetadataPreconf) GetBlobTxListOffset() uint32 {
+	return 0
+}
+
+// GetBlobTxListLength returns zero, since a preconfirmed block carries its tx list inline over
+// gossip rather than in a blob.
+func (m *TaikoDataBlockMetadataPreconf) GetBlobTxListLength() uint32 {
+	return 0
+}
+
+// GetBlobIndex returns zero, since a preconfirmed block carries its tx list inline over gossip
+// rather than in a blob.
+func (m *TaikoDataBlockMetadataPreconf) GetBlobIndex() uint8 {
+	return 0
+}
+
+// GetBasefeeSharingPctg returns zero, since the basefee sharing percentage is only set at
+// proposal time.
+func (m *TaikoDataBlockMetadataPreconf) GetBasefeeSharingPctg() uint8 {
+	return 0
+}
+
+// GetTxIndex returns zero, since a preconfirmed block has no L1 transaction yet.
+func (m *TaikoDataBlockMetadataPreconf) GetTxIndex() uint {
+	return 0
+}
+
+// GetTxHash returns the zero hash, since a preconfirmed block has no L1 transaction yet.
+func (m *TaikoDataBlockMetadataPreconf) GetTxHash() common.Hash {
+	return common.Hash{}
+}
+
+// IsOntakeBlock returns true, since preconfirmation payloads are only produced for the ontake
+// block metadata shape.
+func (m *TaikoDataBlockMetadataPreconf) IsOntakeBlock() bool {
+	return true
+}
+
+// ForkVersion returns ForkPreconf, a version distinct from ForkOntake even though a preconfirmed
+// block's eventual on-chain shape is ontake's: TaikoDataBlockMetadataPreconf is a different
+// concrete type than TaikoDataBlockMetadataOntake, and the fork registry invariant is one
+// concrete type per ForkVersion. Once the driver reconciles this metadata against the on-chain
+// `BlockProposed2` event, it is replaced with a real TaikoDataBlockMetadataOntake before ever
+// reaching the encoder.
+func (m *TaikoDataBlockMetadataPreconf) ForkVersion() ForkVersion {
+	return ForkPreconf
+}
+
+// IsPreconfirmed returns true, marking this metadata as having been received over the
+// preconfirmation gossip network rather than decoded from an on-chain event.
+func (m *TaikoDataBlockMetadataPreconf) IsPreconfirmed() bool {
+	return true
+}